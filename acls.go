@@ -0,0 +1,48 @@
+package chef
+
+import (
+	"context"
+	"net/url"
+)
+
+// ACLPerm is the list of actors and groups granted a single permission
+// (create, read, update, delete or grant) on a Chef object.
+type ACLPerm struct {
+	Actors []string `json:"actors"`
+	Groups []string `json:"groups"`
+}
+
+// ACL is the full set of permissions on a Chef object, keyed by permission
+// name.
+type ACL map[string]ACLPerm
+
+// ACLService exposes the /<type>/<name>/_acl endpoints of the Chef server.
+type ACLService struct {
+	chef *Chef
+}
+
+// Get fetches the ACL for the named object of the given type (e.g. "nodes",
+// "roles", "clients").
+func (s *ACLService) Get(objectType, name string) (ACL, error) {
+	return s.GetContext(context.Background(), objectType, name)
+}
+
+// GetContext is the context-aware variant of Get.
+func (s *ACLService) GetContext(ctx context.Context, objectType, name string) (ACL, error) {
+	var acl ACL
+	if err := s.chef.doJSON(ctx, "GET", "/"+url.PathEscape(objectType)+"/"+url.PathEscape(name)+"/_acl", nil, &acl); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+// Put replaces a single permission (e.g. "read") on the named object.
+func (s *ACLService) Put(objectType, name, permission string, perm ACLPerm) error {
+	return s.PutContext(context.Background(), objectType, name, permission, perm)
+}
+
+// PutContext is the context-aware variant of Put.
+func (s *ACLService) PutContext(ctx context.Context, objectType, name, permission string, perm ACLPerm) error {
+	body := map[string]ACLPerm{permission: perm}
+	return s.chef.doJSON(ctx, "PUT", "/"+url.PathEscape(objectType)+"/"+url.PathEscape(name)+"/_acl/"+url.PathEscape(permission), body, nil)
+}
@@ -0,0 +1,284 @@
+package chef
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/youmark/pkcs8"
+)
+
+// signatureFromHeaders reassembles the base64 signature chunked across the
+// X-Ops-Authorization-N headers, mirroring what a real Chef server does.
+func signatureFromHeaders(h http.Header) ([]byte, error) {
+	var encoded string
+	for i := 1; ; i++ {
+		chunk := h.Get(fmt.Sprintf("X-Ops-Authorization-%d", i))
+		if chunk == "" {
+			break
+		}
+		encoded += chunk
+	}
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+// TestNodePutSignsReceivedBody round-trips a node PUT through a fake Chef
+// server and verifies the X-Ops-Authorization signature against the exact
+// body bytes the server received. This is the regression chunk0-3 fixed:
+// generateRequest used to sign body.Encode() while leaving req.Body nil, so
+// the signature and the wire body never matched.
+func TestNodePutSignsReceivedBody(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	var receivedBody []byte
+	var receivedHeader http.Header
+	var receivedMethod, receivedPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+		receivedBody = body
+		receivedHeader = r.Header.Clone()
+		receivedMethod = r.Method
+		receivedPath = r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	chef := &Chef{
+		Url:         server.URL,
+		Version:     "1.0",
+		UserId:      "test-client",
+		Key:         key,
+		SignVersion: SignVersion13,
+	}
+	chef.initServices()
+
+	node := Node{RunList: []string{"recipe[foo]"}}
+	updated, err := chef.Nodes.Put("testnode", node)
+	if err != nil {
+		t.Fatalf("Nodes.Put: %v", err)
+	}
+	if updated.Name != "testnode" {
+		t.Errorf("updated.Name = %q, want %q", updated.Name, "testnode")
+	}
+
+	wantBody, err := json.Marshal(struct {
+		Name            string                 `json:"name"`
+		ChefEnvironment string                 `json:"chef_environment,omitempty"`
+		RunList         []string               `json:"run_list,omitempty"`
+		JsonClass       string                 `json:"json_class,omitempty"`
+		ChefType        string                 `json:"chef_type,omitempty"`
+		Normal          map[string]interface{} `json:"normal,omitempty"`
+		Automatic       map[string]interface{} `json:"automatic,omitempty"`
+		Default         map[string]interface{} `json:"default,omitempty"`
+		Override        map[string]interface{} `json:"override,omitempty"`
+	}{Name: "testnode", RunList: node.RunList})
+	if err != nil {
+		t.Fatalf("marshal expected body: %v", err)
+	}
+	if string(receivedBody) != string(wantBody) {
+		t.Fatalf("server received body %s, want %s", receivedBody, wantBody)
+	}
+
+	sig, err := signatureFromHeaders(receivedHeader)
+	if err != nil {
+		t.Fatalf("decoding signature headers: %v", err)
+	}
+
+	if got, want := receivedHeader.Get("X-Ops-Server-Api-Version"), "0"; got != want {
+		t.Errorf("X-Ops-Server-Api-Version = %q, want %q", got, want)
+	}
+
+	timestamp := receivedHeader.Get("X-Ops-Timestamp")
+	err = VerifyRequestAuthorization(
+		&key.PublicKey,
+		SignVersion13,
+		receivedMethod,
+		receivedPath,
+		string(receivedBody),
+		timestamp,
+		chef.UserId,
+		chef.serverAPIVersion(),
+		sig,
+	)
+	if err != nil {
+		t.Fatalf("VerifyRequestAuthorization: %v", err)
+	}
+}
+
+func TestShouldRetryStatus(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusCreated:             false,
+		http.StatusBadRequest:          false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+	}
+	for status, want := range cases {
+		if got := shouldRetryStatus(status); got != want {
+			t.Errorf("shouldRetryStatus(%d) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	fallback := 500 * time.Millisecond
+
+	resp := &http.Response{Header: http.Header{}}
+	if got := retryAfter(resp, fallback); got != fallback {
+		t.Errorf("no header: retryAfter() = %v, want %v", got, fallback)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": {"2"}}}
+	if got, want := retryAfter(resp, fallback), 2*time.Second; got != want {
+		t.Errorf("seconds header: retryAfter() = %v, want %v", got, want)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": {"not-a-date"}}}
+	if got := retryAfter(resp, fallback); got != fallback {
+		t.Errorf("unparseable header: retryAfter() = %v, want %v", got, fallback)
+	}
+}
+
+// TestDoContextRetries5xx confirms DoContext retries a 503 response and
+// returns the eventual 200.
+func TestDoContextRetries5xx(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	chef := &Chef{Url: server.URL, UserId: "test-client", Key: key}
+	chef.initServices()
+	chef.Configure(Config{RetryPolicy: RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond}})
+
+	resp, err := chef.Get("/nodes")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("final status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+}
+
+// pemEncode wraps der in a PEM block of the given type.
+func pemEncode(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func TestKeyFromStringPKCS1(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der := x509.MarshalPKCS1PrivateKey(key)
+
+	parsed, err := keyFromString(pemEncode("RSA PRIVATE KEY", der), "")
+	if err != nil {
+		t.Fatalf("keyFromString: %v", err)
+	}
+	if !parsed.Equal(key) {
+		t.Error("parsed key does not match original")
+	}
+}
+
+func TestKeyFromStringEncryptedPKCS1(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der := x509.MarshalPKCS1PrivateKey(key)
+	//lint:ignore SA1019 the only way to produce the legacy format this test covers
+	block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", der, []byte("hunter2"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatalf("EncryptPEMBlock: %v", err)
+	}
+
+	if _, err := keyFromString(pem.EncodeToMemory(block), ""); err != ErrKeyEncrypted {
+		t.Fatalf("keyFromString with no passphrase: err = %v, want ErrKeyEncrypted", err)
+	}
+
+	parsed, err := keyFromString(pem.EncodeToMemory(block), "hunter2")
+	if err != nil {
+		t.Fatalf("keyFromString: %v", err)
+	}
+	if !parsed.Equal(key) {
+		t.Error("parsed key does not match original")
+	}
+}
+
+func TestKeyFromStringPKCS8(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+
+	parsed, err := keyFromString(pemEncode("PRIVATE KEY", der), "")
+	if err != nil {
+		t.Fatalf("keyFromString: %v", err)
+	}
+	if !parsed.Equal(key) {
+		t.Error("parsed key does not match original")
+	}
+}
+
+func TestKeyFromStringEncryptedPKCS8(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	der, err := pkcs8.MarshalPrivateKey(key, []byte("hunter2"), nil)
+	if err != nil {
+		t.Fatalf("pkcs8.MarshalPrivateKey: %v", err)
+	}
+	encoded := pemEncode("ENCRYPTED PRIVATE KEY", der)
+
+	if _, err := keyFromString(encoded, ""); err != ErrKeyEncrypted {
+		t.Fatalf("keyFromString with no passphrase: err = %v, want ErrKeyEncrypted", err)
+	}
+
+	parsed, err := keyFromString(encoded, "hunter2")
+	if err != nil {
+		t.Fatalf("keyFromString: %v", err)
+	}
+	if !parsed.Equal(key) {
+		t.Error("parsed key does not match original")
+	}
+}
@@ -0,0 +1,78 @@
+package chef
+
+import (
+	"context"
+	"net/url"
+)
+
+// Client represents a Chef API client (a node or workstation identity used
+// to authenticate to the server).
+type Client struct {
+	Name       string `json:"name"`
+	ClientName string `json:"clientname,omitempty"`
+	OrgName    string `json:"orgname,omitempty"`
+	Validator  bool   `json:"validator,omitempty"`
+	Admin      bool   `json:"admin,omitempty"`
+	CreateKey  bool   `json:"create_key,omitempty"`
+	PublicKey  string `json:"public_key,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+// ClientService exposes the /clients endpoints of the Chef server.
+type ClientService struct {
+	chef *Chef
+}
+
+// List returns every client registered with the Chef server, mapped to its
+// API URL.
+func (s *ClientService) List() (map[string]string, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *ClientService) ListContext(ctx context.Context) (map[string]string, error) {
+	var clients map[string]string
+	if err := s.chef.doJSON(ctx, "GET", "/clients", nil, &clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// Get fetches a single client by name.
+func (s *ClientService) Get(name string) (*Client, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+// GetContext is the context-aware variant of Get.
+func (s *ClientService) GetContext(ctx context.Context, name string) (*Client, error) {
+	var client Client
+	if err := s.chef.doJSON(ctx, "GET", "/clients/"+url.PathEscape(name), nil, &client); err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+// Put creates or replaces the client with the given name.
+func (s *ClientService) Put(name string, client Client) (*Client, error) {
+	return s.PutContext(context.Background(), name, client)
+}
+
+// PutContext is the context-aware variant of Put.
+func (s *ClientService) PutContext(ctx context.Context, name string, client Client) (*Client, error) {
+	client.Name = name
+	var updated Client
+	if err := s.chef.doJSON(ctx, "PUT", "/clients/"+url.PathEscape(name), client, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Delete removes a client from the Chef server.
+func (s *ClientService) Delete(name string) error {
+	return s.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *ClientService) DeleteContext(ctx context.Context, name string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/clients/"+url.PathEscape(name), nil, nil)
+}
@@ -0,0 +1,80 @@
+package chef
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// CookbookVersion is a single version of a cookbook, as returned by the
+// Chef server's cookbook version manifest.
+type CookbookVersion struct {
+	CookbookName string                 `json:"cookbook_name"`
+	Name         string                 `json:"name"`
+	Version      string                 `json:"version"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Files        []json.RawMessage      `json:"files,omitempty"`
+	Recipes      []json.RawMessage      `json:"recipes,omitempty"`
+	Attributes   []json.RawMessage      `json:"attributes,omitempty"`
+	Templates    []json.RawMessage      `json:"templates,omitempty"`
+	RootFiles    []json.RawMessage      `json:"root_files,omitempty"`
+}
+
+// CookbookService exposes the /cookbooks endpoints of the Chef server.
+type CookbookService struct {
+	chef *Chef
+}
+
+// List returns every cookbook on the Chef server along with its available
+// versions.
+func (s *CookbookService) List() (map[string]json.RawMessage, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *CookbookService) ListContext(ctx context.Context) (map[string]json.RawMessage, error) {
+	var cookbooks map[string]json.RawMessage
+	if err := s.chef.doJSON(ctx, "GET", "/cookbooks", nil, &cookbooks); err != nil {
+		return nil, err
+	}
+	return cookbooks, nil
+}
+
+// Get returns the available versions of a single cookbook.
+func (s *CookbookService) Get(name string) (json.RawMessage, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+// GetContext is the context-aware variant of Get.
+func (s *CookbookService) GetContext(ctx context.Context, name string) (json.RawMessage, error) {
+	var cookbook json.RawMessage
+	if err := s.chef.doJSON(ctx, "GET", "/cookbooks/"+url.PathEscape(name), nil, &cookbook); err != nil {
+		return nil, err
+	}
+	return cookbook, nil
+}
+
+// GetVersion returns a single cookbook version's manifest. Use "_latest" as
+// the version to fetch the newest available version.
+func (s *CookbookService) GetVersion(name, version string) (*CookbookVersion, error) {
+	return s.GetVersionContext(context.Background(), name, version)
+}
+
+// GetVersionContext is the context-aware variant of GetVersion.
+func (s *CookbookService) GetVersionContext(ctx context.Context, name, version string) (*CookbookVersion, error) {
+	var cookbookVersion CookbookVersion
+	if err := s.chef.doJSON(ctx, "GET", "/cookbooks/"+url.PathEscape(name)+"/"+url.PathEscape(version), nil, &cookbookVersion); err != nil {
+		return nil, err
+	}
+	return &cookbookVersion, nil
+}
+
+// Delete removes a single cookbook version from the Chef server.
+func (s *CookbookService) Delete(name, version string) error {
+	return s.DeleteContext(context.Background(), name, version)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *CookbookService) DeleteContext(ctx context.Context, name, version string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/cookbooks/"+url.PathEscape(name)+"/"+url.PathEscape(version), nil, nil)
+}
@@ -0,0 +1,108 @@
+package chef
+
+import (
+	"context"
+	"net/url"
+)
+
+// DataBagItem is a single item stored in a Chef data bag. Items are
+// free-form JSON documents, keyed by an "id" field.
+type DataBagItem map[string]interface{}
+
+// DataBagService exposes the /data endpoints of the Chef server.
+type DataBagService struct {
+	chef *Chef
+}
+
+// List returns every data bag on the Chef server, mapped to its API URL.
+func (s *DataBagService) List() (map[string]string, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *DataBagService) ListContext(ctx context.Context) (map[string]string, error) {
+	var bags map[string]string
+	if err := s.chef.doJSON(ctx, "GET", "/data", nil, &bags); err != nil {
+		return nil, err
+	}
+	return bags, nil
+}
+
+// Create registers a new, empty data bag.
+func (s *DataBagService) Create(name string) error {
+	return s.CreateContext(context.Background(), name)
+}
+
+// CreateContext is the context-aware variant of Create.
+func (s *DataBagService) CreateContext(ctx context.Context, name string) error {
+	return s.chef.doJSON(ctx, "POST", "/data", map[string]string{"name": name}, nil)
+}
+
+// Delete removes a data bag and all of its items.
+func (s *DataBagService) Delete(name string) error {
+	return s.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *DataBagService) DeleteContext(ctx context.Context, name string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/data/"+url.PathEscape(name), nil, nil)
+}
+
+// ListItems returns every item in the given data bag, mapped to its API URL.
+func (s *DataBagService) ListItems(bag string) (map[string]string, error) {
+	return s.ListItemsContext(context.Background(), bag)
+}
+
+// ListItemsContext is the context-aware variant of ListItems.
+func (s *DataBagService) ListItemsContext(ctx context.Context, bag string) (map[string]string, error) {
+	var items map[string]string
+	if err := s.chef.doJSON(ctx, "GET", "/data/"+url.PathEscape(bag), nil, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetItem fetches a single item from a data bag.
+func (s *DataBagService) GetItem(bag, item string) (DataBagItem, error) {
+	return s.GetItemContext(context.Background(), bag, item)
+}
+
+// GetItemContext is the context-aware variant of GetItem.
+func (s *DataBagService) GetItemContext(ctx context.Context, bag, item string) (DataBagItem, error) {
+	var result DataBagItem
+	if err := s.chef.doJSON(ctx, "GET", "/data/"+url.PathEscape(bag)+"/"+url.PathEscape(item), nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PutItem creates or replaces an item in a data bag.
+func (s *DataBagService) PutItem(bag string, item DataBagItem) (DataBagItem, error) {
+	return s.PutItemContext(context.Background(), bag, item)
+}
+
+// PutItemContext is the context-aware variant of PutItem.
+func (s *DataBagService) PutItemContext(ctx context.Context, bag string, item DataBagItem) (DataBagItem, error) {
+	var result DataBagItem
+	if err := s.chef.doJSON(ctx, "PUT", "/data/"+url.PathEscape(bag)+"/"+url.PathEscape(itemID(item)), item, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteItem removes a single item from a data bag.
+func (s *DataBagService) DeleteItem(bag, item string) error {
+	return s.DeleteItemContext(context.Background(), bag, item)
+}
+
+// DeleteItemContext is the context-aware variant of DeleteItem.
+func (s *DataBagService) DeleteItemContext(ctx context.Context, bag, item string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/data/"+url.PathEscape(bag)+"/"+url.PathEscape(item), nil, nil)
+}
+
+// itemID returns the "id" field of a data bag item, as required on the
+// request path for PUT.
+func itemID(item DataBagItem) string {
+	id, _ := item["id"].(string)
+	return id
+}
@@ -0,0 +1,76 @@
+package chef
+
+import (
+	"context"
+	"net/url"
+)
+
+// Environment represents a Chef environment document.
+type Environment struct {
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
+	JsonClass          string                 `json:"json_class,omitempty"`
+	ChefType           string                 `json:"chef_type,omitempty"`
+	DefaultAttributes  map[string]interface{} `json:"default_attributes,omitempty"`
+	OverrideAttributes map[string]interface{} `json:"override_attributes,omitempty"`
+	CookbookVersions   map[string]string      `json:"cookbook_versions,omitempty"`
+}
+
+// EnvironmentService exposes the /environments endpoints of the Chef server.
+type EnvironmentService struct {
+	chef *Chef
+}
+
+// List returns every environment registered with the Chef server, mapped to
+// its API URL.
+func (s *EnvironmentService) List() (map[string]string, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *EnvironmentService) ListContext(ctx context.Context) (map[string]string, error) {
+	var environments map[string]string
+	if err := s.chef.doJSON(ctx, "GET", "/environments", nil, &environments); err != nil {
+		return nil, err
+	}
+	return environments, nil
+}
+
+// Get fetches a single environment by name.
+func (s *EnvironmentService) Get(name string) (*Environment, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+// GetContext is the context-aware variant of Get.
+func (s *EnvironmentService) GetContext(ctx context.Context, name string) (*Environment, error) {
+	var environment Environment
+	if err := s.chef.doJSON(ctx, "GET", "/environments/"+url.PathEscape(name), nil, &environment); err != nil {
+		return nil, err
+	}
+	return &environment, nil
+}
+
+// Put creates or replaces the environment with the given name.
+func (s *EnvironmentService) Put(name string, environment Environment) (*Environment, error) {
+	return s.PutContext(context.Background(), name, environment)
+}
+
+// PutContext is the context-aware variant of Put.
+func (s *EnvironmentService) PutContext(ctx context.Context, name string, environment Environment) (*Environment, error) {
+	environment.Name = name
+	var updated Environment
+	if err := s.chef.doJSON(ctx, "PUT", "/environments/"+url.PathEscape(name), environment, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Delete removes an environment from the Chef server.
+func (s *EnvironmentService) Delete(name string) error {
+	return s.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *EnvironmentService) DeleteContext(ctx context.Context, name string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/environments/"+url.PathEscape(name), nil, nil)
+}
@@ -0,0 +1,78 @@
+package chef
+
+import (
+	"context"
+	"net/url"
+)
+
+// Node represents a Chef node document.
+type Node struct {
+	Name            string                 `json:"name"`
+	ChefEnvironment string                 `json:"chef_environment,omitempty"`
+	RunList         []string               `json:"run_list,omitempty"`
+	JsonClass       string                 `json:"json_class,omitempty"`
+	ChefType        string                 `json:"chef_type,omitempty"`
+	Normal          map[string]interface{} `json:"normal,omitempty"`
+	Automatic       map[string]interface{} `json:"automatic,omitempty"`
+	Default         map[string]interface{} `json:"default,omitempty"`
+	Override        map[string]interface{} `json:"override,omitempty"`
+}
+
+// NodeService exposes the /nodes endpoints of the Chef server.
+type NodeService struct {
+	chef *Chef
+}
+
+// List returns every node registered with the Chef server, mapped to its API
+// URL.
+func (s *NodeService) List() (map[string]string, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *NodeService) ListContext(ctx context.Context) (map[string]string, error) {
+	var nodes map[string]string
+	if err := s.chef.doJSON(ctx, "GET", "/nodes", nil, &nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// Get fetches a single node by name.
+func (s *NodeService) Get(name string) (*Node, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+// GetContext is the context-aware variant of Get.
+func (s *NodeService) GetContext(ctx context.Context, name string) (*Node, error) {
+	var node Node
+	if err := s.chef.doJSON(ctx, "GET", "/nodes/"+url.PathEscape(name), nil, &node); err != nil {
+		return nil, err
+	}
+	return &node, nil
+}
+
+// Put creates or replaces the node with the given name.
+func (s *NodeService) Put(name string, node Node) (*Node, error) {
+	return s.PutContext(context.Background(), name, node)
+}
+
+// PutContext is the context-aware variant of Put.
+func (s *NodeService) PutContext(ctx context.Context, name string, node Node) (*Node, error) {
+	node.Name = name
+	var updated Node
+	if err := s.chef.doJSON(ctx, "PUT", "/nodes/"+url.PathEscape(name), node, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Delete removes a node from the Chef server.
+func (s *NodeService) Delete(name string) error {
+	return s.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *NodeService) DeleteContext(ctx context.Context, name string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/nodes/"+url.PathEscape(name), nil, nil)
+}
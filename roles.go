@@ -0,0 +1,77 @@
+package chef
+
+import (
+	"context"
+	"net/url"
+)
+
+// Role represents a Chef role document.
+type Role struct {
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
+	JsonClass          string                 `json:"json_class,omitempty"`
+	ChefType           string                 `json:"chef_type,omitempty"`
+	DefaultAttributes  map[string]interface{} `json:"default_attributes,omitempty"`
+	OverrideAttributes map[string]interface{} `json:"override_attributes,omitempty"`
+	RunList            []string               `json:"run_list,omitempty"`
+	EnvRunLists        map[string][]string    `json:"env_run_lists,omitempty"`
+}
+
+// RoleService exposes the /roles endpoints of the Chef server.
+type RoleService struct {
+	chef *Chef
+}
+
+// List returns every role registered with the Chef server, mapped to its API
+// URL.
+func (s *RoleService) List() (map[string]string, error) {
+	return s.ListContext(context.Background())
+}
+
+// ListContext is the context-aware variant of List.
+func (s *RoleService) ListContext(ctx context.Context) (map[string]string, error) {
+	var roles map[string]string
+	if err := s.chef.doJSON(ctx, "GET", "/roles", nil, &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// Get fetches a single role by name.
+func (s *RoleService) Get(name string) (*Role, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+// GetContext is the context-aware variant of Get.
+func (s *RoleService) GetContext(ctx context.Context, name string) (*Role, error) {
+	var role Role
+	if err := s.chef.doJSON(ctx, "GET", "/roles/"+url.PathEscape(name), nil, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// Put creates or replaces the role with the given name.
+func (s *RoleService) Put(name string, role Role) (*Role, error) {
+	return s.PutContext(context.Background(), name, role)
+}
+
+// PutContext is the context-aware variant of Put.
+func (s *RoleService) PutContext(ctx context.Context, name string, role Role) (*Role, error) {
+	role.Name = name
+	var updated Role
+	if err := s.chef.doJSON(ctx, "PUT", "/roles/"+url.PathEscape(name), role, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Delete removes a role from the Chef server.
+func (s *RoleService) Delete(name string) error {
+	return s.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (s *RoleService) DeleteContext(ctx context.Context, name string) error {
+	return s.chef.doJSON(ctx, "DELETE", "/roles/"+url.PathEscape(name), nil, nil)
+}
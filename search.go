@@ -0,0 +1,144 @@
+package chef
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// SearchResult is a single page of results from the Chef server's
+// /search/<index> endpoint.
+type SearchResult struct {
+	Total int               `json:"total"`
+	Start int               `json:"start"`
+	Rows  []json.RawMessage `json:"rows"`
+}
+
+// SearchService exposes the /search endpoints of the Chef server.
+type SearchService struct {
+	chef *Chef
+}
+
+// Exec runs a search query against the given index (e.g. "node", "role",
+// "client", or a data bag name), returning at most rows results starting at
+// offset start.
+func (s *SearchService) Exec(index, query string, rows, start int) (*SearchResult, error) {
+	return s.ExecContext(context.Background(), index, query, rows, start)
+}
+
+// ExecContext is the context-aware variant of Exec.
+func (s *SearchService) ExecContext(ctx context.Context, index, query string, rows, start int) (*SearchResult, error) {
+	path := "/search/" + url.PathEscape(index) + fmt.Sprintf("?q=%s&rows=%d&start=%d", url.QueryEscape(query), rows, start)
+	var result SearchResult
+	if err := s.chef.doJSON(ctx, "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PartialExec runs a partial search against index: rather than returning
+// whole documents, the server returns, for each match, only the fields
+// named in keys, each mapped to the attribute path to pull it from (e.g.
+// map[string][]string{"name": {"name"}, "ip": {"ipaddress"}}). It returns a
+// SearchIterator that transparently re-issues the query with an
+// incremented start offset as each page is exhausted, until every matching
+// row has been seen.
+func (s *SearchService) PartialExec(index, query string, keys map[string][]string, rows, start int) (*SearchIterator, error) {
+	return s.PartialExecContext(context.Background(), index, query, keys, rows, start)
+}
+
+// PartialExecContext is the context-aware variant of PartialExec.
+func (s *SearchService) PartialExecContext(ctx context.Context, index, query string, keys map[string][]string, rows, start int) (*SearchIterator, error) {
+	page, err := s.partialPage(ctx, index, query, keys, rows, start)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchIterator{
+		service: s,
+		index:   index,
+		query:   query,
+		keys:    keys,
+		rows:    rows,
+		page:    page,
+		pos:     -1,
+		fetched: start + len(page.Rows),
+	}, nil
+}
+
+// partialPage fetches a single page of a partial search.
+func (s *SearchService) partialPage(ctx context.Context, index, query string, keys map[string][]string, rows, start int) (*SearchResult, error) {
+	path := "/search/" + url.PathEscape(index) + fmt.Sprintf("?q=%s&rows=%d&start=%d", url.QueryEscape(query), rows, start)
+	var result SearchResult
+	if err := s.chef.doJSON(ctx, "POST", path, keys, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SearchIterator pages through the full result set of a partial search,
+// fetching each subsequent page from the server only once the current one
+// is exhausted.
+type SearchIterator struct {
+	service *SearchService
+	index   string
+	query   string
+	keys    map[string][]string
+	rows    int
+
+	page    *SearchResult
+	pos     int
+	fetched int
+	err     error
+}
+
+// Next advances the iterator and reports whether a row is available via
+// Row. It returns false once every matching row has been seen or a
+// request fails; check Err to distinguish the two.
+func (it *SearchIterator) Next() bool {
+	return it.NextContext(context.Background())
+}
+
+// NextContext is the context-aware variant of Next.
+func (it *SearchIterator) NextContext(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	if it.pos+1 < len(it.page.Rows) {
+		it.pos++
+		return true
+	}
+	if it.fetched >= it.page.Total {
+		return false
+	}
+
+	next, err := it.service.partialPage(ctx, it.index, it.query, it.keys, it.rows, it.fetched)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.page = next
+	it.fetched += len(next.Rows)
+	if len(next.Rows) == 0 {
+		return false
+	}
+	it.pos = 0
+	return true
+}
+
+// Row returns the partial search result at the iterator's current
+// position, as set by the most recent call to Next.
+func (it *SearchIterator) Row() json.RawMessage {
+	return it.page.Rows[it.pos]
+}
+
+// Total returns the total number of rows matching the search, across all
+// pages.
+func (it *SearchIterator) Total() int {
+	return it.page.Total
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,100 @@
+package chef
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// TestSearchIteratorPaging confirms PartialExec's iterator transparently
+// fetches successive pages as it runs off the end of each one, stopping once
+// every matching row has been returned.
+func TestSearchIteratorPaging(t *testing.T) {
+	const total = 5
+	const pageSize = 2
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+
+		var rows []json.RawMessage
+		for i := start; i < start+pageSize && i < total; i++ {
+			rows = append(rows, json.RawMessage(fmt.Sprintf(`{"name":"node%d"}`, i)))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{Total: total, Start: start, Rows: rows})
+	}))
+	defer server.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	chef := &Chef{Url: server.URL, UserId: "test-client", Key: key}
+	chef.initServices()
+
+	it, err := chef.Search.PartialExec("node", "*:*", map[string][]string{"name": {"name"}}, pageSize, 0)
+	if err != nil {
+		t.Fatalf("PartialExec: %v", err)
+	}
+
+	var got []string
+	for it.Next() {
+		var row struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(it.Row(), &row); err != nil {
+			t.Fatalf("unmarshal row: %v", err)
+		}
+		got = append(got, row.Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("iterator error: %v", err)
+	}
+
+	if len(got) != total {
+		t.Fatalf("got %d rows, want %d", len(got), total)
+	}
+	for i, name := range got {
+		if want := fmt.Sprintf("node%d", i); name != want {
+			t.Errorf("row %d = %q, want %q", i, name, want)
+		}
+	}
+	if it.Total() != total {
+		t.Errorf("Total() = %d, want %d", it.Total(), total)
+	}
+}
+
+// TestSearchExecEscapesIndex confirms Exec escapes index into its own path
+// segment rather than interpolating it unescaped, so a value containing "/"
+// or "?" can't redirect the request to a different endpoint.
+func TestSearchExecEscapesIndex(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SearchResult{})
+	}))
+	defer server.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	chef := &Chef{Url: server.URL, UserId: "test-client", Key: key}
+	chef.initServices()
+
+	if _, err := chef.Search.Exec("node/../secrets", "*:*", 10, 0); err != nil {
+		t.Fatalf("Exec: %v", err)
+	}
+
+	const want = "/search/node%2F..%2Fsecrets"
+	if gotPath != want {
+		t.Errorf("request path = %q, want %q", gotPath, want)
+	}
+}